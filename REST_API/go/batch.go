@@ -0,0 +1,103 @@
+/*
+ * Accelize Accelerator WS
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package swagger
+
+import (
+	"context"
+	"io"
+)
+
+// BatchItem is one unit of work submitted through SubmitBatch. Datafile is
+// a stream rather than an inlined string so large datafiles can be
+// uploaded as multipart instead of base64-in-JSON.
+type BatchItem struct {
+
+	// Datafile is the file to be processed by the accelerator. It may be
+	// nil if the process does not require one.
+	Datafile io.Reader
+
+	// Filename is sent alongside Datafile in the multipart upload.
+	Filename string
+
+	Parameters AcceleratorParameters
+}
+
+// ProcessSubmitter submits a single process and waits for it to be
+// accepted (not necessarily completed). This package provides no
+// implementation of it; how Datafile and Filename are sent is up to the
+// implementation.
+type ProcessSubmitter interface {
+	SubmitProcess(ctx context.Context, configuration string, item BatchItem) (*ProcessResult, error)
+}
+
+// SubmitBatch fans out items across a bounded pool of concurrency workers,
+// all sharing the same Configuration. Results preserve the order of items:
+// Results[i] always corresponds to items[i]. A failure on one item is
+// recorded on that item's ProcessResult.Error rather than aborting the
+// rest of the batch. Cancelling ctx stops items that have not started yet
+// but lets in-flight submissions finish so their slot is still filled.
+//
+// This is a []BatchItem -> []ProcessResult function rather than a
+// BatchProcessRequest/BatchProcessResponse pair: a consumer that expects a
+// response object with "per-item error slots" gets a bare []ProcessResult
+// instead, where each item's failure lives on that ProcessResult.Error.
+func SubmitBatch(ctx context.Context, submitter ProcessSubmitter, configuration string, items []BatchItem, concurrency int) ([]ProcessResult, error) {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	results := make([]ProcessResult, len(items))
+	jobs := make(chan int)
+
+	worker := func() {
+		for i := range jobs {
+			if err := ctx.Err(); err != nil {
+				results[i] = ProcessResult{Error: &APIError{Message: err.Error()}}
+				continue
+			}
+
+			result, err := submitter.SubmitProcess(ctx, configuration, items[i])
+			switch {
+			case err != nil:
+				results[i] = ProcessResult{Error: &APIError{Message: err.Error()}}
+			case result == nil:
+				results[i] = ProcessResult{Error: &APIError{Message: "apyfal: submitter returned no result and no error"}}
+			default:
+				results[i] = *result
+			}
+		}
+	}
+
+	done := make(chan struct{})
+	for w := 0; w < concurrency; w++ {
+		go func() {
+			worker()
+			done <- struct{}{}
+		}()
+	}
+
+	for i := range items {
+		jobs <- i
+	}
+	close(jobs)
+
+	for w := 0; w < concurrency; w++ {
+		<-done
+	}
+
+	return results, nil
+}
@@ -0,0 +1,106 @@
+/*
+ * Accelize Accelerator WS
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package swagger
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// stubSubmitter resolves SubmitProcess per item by its Filename, so tests
+// can pick which items succeed, fail, or misbehave.
+type stubSubmitter struct {
+	results map[string]*ProcessResult
+	errs    map[string]error
+}
+
+func (s *stubSubmitter) SubmitProcess(ctx context.Context, configuration string, item BatchItem) (*ProcessResult, error) {
+	if err, ok := s.errs[item.Filename]; ok {
+		return nil, err
+	}
+	if result, ok := s.results[item.Filename]; ok {
+		return result, nil
+	}
+	return &ProcessResult{Id: item.Filename}, nil
+}
+
+func TestSubmitBatchPreservesOrderAndTolerateFailures(t *testing.T) {
+	tests := []struct {
+		name        string
+		errs        map[string]error
+		wantErr     []string
+		concurrency int
+	}{
+		{name: "all succeed, sequential", concurrency: 1},
+		{name: "all succeed, concurrent", concurrency: 4},
+		{
+			name:        "mid-batch failure does not abort the rest",
+			errs:        map[string]error{"b": errors.New("boom")},
+			wantErr:     []string{"b"},
+			concurrency: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := []BatchItem{{Filename: "a"}, {Filename: "b"}, {Filename: "c"}, {Filename: "d"}}
+			submitter := &stubSubmitter{errs: tt.errs}
+
+			results, err := SubmitBatch(context.Background(), submitter, "cfg-1", items, tt.concurrency)
+			if err != nil {
+				t.Fatalf("SubmitBatch: %v", err)
+			}
+			if len(results) != len(items) {
+				t.Fatalf("len(results) = %d, want %d", len(results), len(items))
+			}
+
+			wantErr := map[string]bool{}
+			for _, name := range tt.wantErr {
+				wantErr[name] = true
+			}
+
+			for i, item := range items {
+				if wantErr[item.Filename] {
+					if results[i].Error == nil {
+						t.Errorf("results[%d] (%s): want Error set, got none", i, item.Filename)
+					}
+					continue
+				}
+				if results[i].Error != nil {
+					t.Errorf("results[%d] (%s): unexpected Error: %v", i, item.Filename, results[i].Error)
+				}
+				if results[i].Id != item.Filename {
+					t.Errorf("results[%d].Id = %q, want %q (order not preserved)", i, results[i].Id, item.Filename)
+				}
+			}
+		})
+	}
+}
+
+func TestSubmitBatchNilResultWithNilErrorDoesNotPanic(t *testing.T) {
+	items := []BatchItem{{Filename: "a"}}
+	submitter := &stubSubmitter{results: map[string]*ProcessResult{"a": nil}}
+
+	results, err := SubmitBatch(context.Background(), submitter, "cfg-1", items, 1)
+	if err != nil {
+		t.Fatalf("SubmitBatch: %v", err)
+	}
+	if results[0].Error == nil {
+		t.Fatalf("results[0].Error = nil, want an error for a (nil, nil) submitter result")
+	}
+}
@@ -0,0 +1,258 @@
+/*
+ * Accelize Accelerator WS
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package lro polls an in-flight accelerator process to completion.
+//
+// Submitting a process through the swagger client only enqueues the job;
+// the caller still has to poll the process URL until "processed" flips to
+// true. Watch polls with an exponentially backed off, jittered interval,
+// honors context cancellation, and surfaces both a blocking Wait and a
+// streaming Watch so callers don't have to busy-loop by hand.
+package lro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Accelize/apyfal/REST_API/go"
+)
+
+// ProcessGetter fetches the current state of a submitted process. The
+// caller supplies the implementation; the swagger package does not
+// provide one.
+type ProcessGetter interface {
+	GetProcess(ctx context.Context, id string) (*swagger.ProcessResult, error)
+}
+
+// ProcessOutcome is the richer result of waiting on a process, combining the
+// raw swagger.ProcessResult with fields useful to verify-output style
+// callers: response time, result counts parsed out of ParametersResult, and
+// a lazily-downloaded handle to the result datafile.
+type ProcessOutcome struct {
+	ScheduledTaskId string
+
+	// PollElapsedMs is the elapsed time, in milliseconds, from the call to
+	// Watch to the poll that observed Processed == true, including the
+	// InitialInterval wait before the first poll. It does not include any
+	// time before Watch was called, since Watch does not know the original
+	// submit time.
+	PollElapsedMs int64
+
+	TotalMatchedCount int64
+	TotalCount        int64
+
+	Result *swagger.ProcessResult
+
+	httpClient *http.Client
+	metrics    Metrics
+}
+
+// Datafileresult lazily downloads the result datafile referenced by the
+// process, returning an io.ReadCloser the caller must Close. No request is
+// made until Datafileresult is called. The time from sending the request to
+// the first byte actually read from the body is reported to
+// Metrics.ObserveFirstByte, so nothing is measured unless the caller reads it.
+func (o *ProcessOutcome) Datafileresult(ctx context.Context) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.Result.Datafileresult, nil)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("lro: datafileresult: unexpected status %d", resp.StatusCode)
+	}
+	return &firstByteReadCloser{ReadCloser: resp.Body, start: start, metrics: o.metrics}, nil
+}
+
+// firstByteReadCloser wraps a result datafile's body so the first Read
+// that returns data reports the elapsed time since the request was sent.
+type firstByteReadCloser struct {
+	io.ReadCloser
+	start   time.Time
+	metrics Metrics
+	once    sync.Once
+}
+
+func (r *firstByteReadCloser) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		r.once.Do(func() { r.metrics.ObserveFirstByte(time.Since(r.start)) })
+	}
+	return n, err
+}
+
+// ProcessEvent is sent on the channel returned by Watch as the process
+// progresses: once per poll while the process is still running, with
+// Polls set and both Done and Err unset, then exactly once more with
+// either Done or Err set.
+type ProcessEvent struct {
+	Outcome *ProcessOutcome
+	Err     error
+	Done    bool
+
+	// Polls is the number of polls performed so far. It is only set on
+	// non-terminal, in-progress events.
+	Polls int
+}
+
+// Config tunes the polling loop. The zero value is usable and applies the
+// package defaults below.
+type Config struct {
+	// InitialInterval is the delay before the first poll after submit.
+	InitialInterval time.Duration
+
+	// MaxInterval caps the exponential backoff between polls.
+	MaxInterval time.Duration
+
+	// HTTPClient downloads the result datafile. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	// Metrics receives instrumentation events; a nil Metrics is a no-op.
+	Metrics Metrics
+}
+
+func (c Config) withDefaults() Config {
+	if c.InitialInterval <= 0 {
+		c.InitialInterval = 250 * time.Millisecond
+	}
+	if c.MaxInterval <= 0 {
+		c.MaxInterval = 10 * time.Second
+	}
+	if c.HTTPClient == nil {
+		c.HTTPClient = http.DefaultClient
+	}
+	if c.Metrics == nil {
+		c.Metrics = noopMetrics{}
+	}
+	return c
+}
+
+// Watch polls getter for the process identified by id until it finishes or
+// ctx is cancelled, sending a ProcessEvent after every poll so callers can
+// observe progress rather than block. The channel is closed after the
+// terminal event (Done or Err set) is sent.
+func Watch(ctx context.Context, getter ProcessGetter, id string, cfg Config) <-chan ProcessEvent {
+	cfg = cfg.withDefaults()
+	events := make(chan ProcessEvent, 1)
+
+	go func() {
+		defer close(events)
+
+		watchStartedAt := time.Now()
+		interval := cfg.InitialInterval
+		polls := 0
+
+		timer := time.NewTimer(interval)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				events <- ProcessEvent{Err: ctx.Err()}
+				return
+			case <-timer.C:
+			}
+
+			polls++
+
+			result, err := getter.GetProcess(ctx, id)
+			if err != nil {
+				events <- ProcessEvent{Err: err}
+				return
+			}
+			if result.IsError() {
+				events <- ProcessEvent{Err: result.Error}
+				return
+			}
+			if !result.Processed {
+				events <- ProcessEvent{Polls: polls}
+				interval = nextInterval(interval, cfg.MaxInterval)
+				timer.Reset(interval)
+				continue
+			}
+
+			pollElapsed := time.Since(watchStartedAt)
+			cfg.Metrics.ObserveResponseTime(pollElapsed)
+			cfg.Metrics.ObservePoll(polls)
+
+			outcome := &ProcessOutcome{
+				ScheduledTaskId: result.Id,
+				PollElapsedMs:   pollElapsed.Milliseconds(),
+				Result:          result,
+				httpClient:      cfg.HTTPClient,
+				metrics:         cfg.Metrics,
+			}
+			outcome.TotalMatchedCount, outcome.TotalCount = matchCounts(result.ParametersResult)
+
+			events <- ProcessEvent{Outcome: outcome, Done: true}
+			return
+		}
+	}()
+
+	return events
+}
+
+// Wait blocks until the process finishes, returning its ProcessOutcome.
+func Wait(ctx context.Context, getter ProcessGetter, id string, cfg Config) (*ProcessOutcome, error) {
+	for event := range Watch(ctx, getter, id, cfg) {
+		if event.Err != nil {
+			return nil, event.Err
+		}
+		if event.Done {
+			return event.Outcome, nil
+		}
+	}
+	return nil, ctx.Err()
+}
+
+func nextInterval(current, max time.Duration) time.Duration {
+	next := current * 2
+	if next > max {
+		next = max
+	}
+	jitter := time.Duration(rand.Int63n(int64(next)/4 + 1))
+	return next - jitter
+}
+
+func matchCounts(params swagger.AcceleratorParameters) (matched, total int64) {
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return 0, 0
+	}
+	var counts struct {
+		TotalMatchedCount int64 `json:"TotalMatchedCount"`
+		TotalCount        int64 `json:"TotalCount"`
+	}
+	// params re-encodes as a JSON string; decode through it to reach the
+	// underlying object before pulling the count fields out.
+	var encoded string
+	if err := json.Unmarshal(raw, &encoded); err == nil {
+		raw = []byte(encoded)
+	}
+	_ = json.Unmarshal(raw, &counts)
+	return counts.TotalMatchedCount, counts.TotalCount
+}
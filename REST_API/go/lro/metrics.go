@@ -0,0 +1,98 @@
+/*
+ * Accelize Accelerator WS
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lro
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics receives instrumentation events from Watch/Wait. Implementations
+// must be safe for concurrent use.
+type Metrics interface {
+	// ObserveResponseTime records the poll-loop latency of a completed
+	// process: the time from the call to Watch, including the wait before
+	// the first poll, to the poll that observed Processed == true. It does
+	// not include any time before Watch was called.
+	ObserveResponseTime(d time.Duration)
+
+	// ObservePoll is called once per completed process, with the total
+	// number of polls it took to observe Processed == true.
+	ObservePoll(count int)
+
+	// ObserveFirstByte records the time from requesting the result
+	// datafile to the first byte read from it. It is only called when the
+	// caller downloads the datafile with ProcessOutcome.Datafileresult and
+	// reads from it.
+	ObserveFirstByte(d time.Duration)
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) ObserveResponseTime(time.Duration) {}
+func (noopMetrics) ObservePoll(int)                   {}
+func (noopMetrics) ObserveFirstByte(time.Duration)    {}
+
+// PrometheusMetrics is a Metrics implementation that exposes the standard
+// collectors expected by a Prometheus scraper.
+type PrometheusMetrics struct {
+	ResponseTime prometheus.Histogram
+	PollCount    prometheus.Histogram
+	FirstByte    prometheus.Histogram
+}
+
+// NewPrometheusMetrics creates and registers a PrometheusMetrics with reg.
+func NewPrometheusMetrics(reg prometheus.Registerer) *PrometheusMetrics {
+	m := &PrometheusMetrics{
+		ResponseTime: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "apyfal",
+			Subsystem: "lro",
+			Name:      "response_time_seconds",
+			Help:      "Time from the call to Watch to the process completing.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+		PollCount: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "apyfal",
+			Subsystem: "lro",
+			Name:      "poll_count",
+			Help:      "Number of polls performed before a process completed.",
+			Buckets:   prometheus.LinearBuckets(1, 1, 20),
+		}),
+		FirstByte: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "apyfal",
+			Subsystem: "lro",
+			Name:      "result_first_byte_seconds",
+			Help:      "Time to the first byte of the result datafile.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+	}
+	reg.MustRegister(m.ResponseTime, m.PollCount, m.FirstByte)
+	return m
+}
+
+func (m *PrometheusMetrics) ObserveResponseTime(d time.Duration) {
+	m.ResponseTime.Observe(d.Seconds())
+}
+
+func (m *PrometheusMetrics) ObservePoll(count int) {
+	m.PollCount.Observe(float64(count))
+}
+
+func (m *PrometheusMetrics) ObserveFirstByte(d time.Duration) {
+	m.FirstByte.Observe(d.Seconds())
+}
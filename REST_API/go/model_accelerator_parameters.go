@@ -0,0 +1,61 @@
+/*
+ * Accelize Accelerator WS
+ *
+ * No descripton provided (generated by openapi-gen https://github.com/kubernetes/gengo)
+ *
+ * OpenAPI spec version: 1.0
+ *
+ * Generated by: hack/update-codegen.sh
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package swagger
+
+import "encoding/json"
+
+// AcceleratorParameters is the typed replacement for the stringly-typed
+// "parameters"/"parametersresult" fields. On the wire the Accelerator WS
+// still embeds these as a JSON-encoded string within the surrounding JSON
+// document, so MarshalJSON/UnmarshalJSON re-encode through that embedded
+// string transparently.
+type AcceleratorParameters map[string]interface{}
+
+// MarshalJSON encodes p as a JSON object, then re-encodes that object as a
+// JSON string so it matches the embedded-string wire format.
+func (p AcceleratorParameters) MarshalJSON() ([]byte, error) {
+	inner, err := json.Marshal(map[string]interface{}(p))
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(inner))
+}
+
+// UnmarshalJSON expects a JSON string containing a JSON object, as sent by
+// the Accelerator WS, and decodes it into p.
+func (p *AcceleratorParameters) UnmarshalJSON(data []byte) error {
+	var encoded string
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+	if encoded == "" {
+		*p = AcceleratorParameters{}
+		return nil
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal([]byte(encoded), &decoded); err != nil {
+		return err
+	}
+	*p = AcceleratorParameters(decoded)
+	return nil
+}
@@ -0,0 +1,52 @@
+/*
+ * Accelize Accelerator WS
+ *
+ * No descripton provided (generated by openapi-gen https://github.com/kubernetes/gengo)
+ *
+ * OpenAPI spec version: 1.0
+ *
+ * Generated by: hack/update-codegen.sh
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package swagger
+
+import "fmt"
+
+// APIError is the structured error payload returned by the Accelerator WS
+// whenever a process or configuration request fails, in place of the bare
+// "inerror" boolean used by earlier versions of this client.
+type APIError struct {
+
+	// HTTP-equivalent status code of the failure.
+	Code int `json:"code,omitempty"`
+
+	// Short, human readable summary of the error.
+	Message string `json:"message,omitempty"`
+
+	// Additional context, such as the accelerator's stderr, when available.
+	Details string `json:"details,omitempty"`
+}
+
+// Error implements the error interface so an *APIError can be returned
+// directly from client calls.
+func (e *APIError) Error() string {
+	if e == nil {
+		return ""
+	}
+	if e.Details != "" {
+		return fmt.Sprintf("apyfal: %d: %s: %s", e.Code, e.Message, e.Details)
+	}
+	return fmt.Sprintf("apyfal: %d: %s", e.Code, e.Message)
+}
@@ -0,0 +1,69 @@
+/*
+ * Accelize Accelerator WS
+ *
+ * No descripton provided (generated by openapi-gen https://github.com/kubernetes/gengo)
+ *
+ * OpenAPI spec version: 1.0
+ *
+ * Generated by: hack/update-codegen.sh
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package swagger
+
+import "time"
+
+// ProcessResult replaces the old InlineResponse2003Results generated by
+// swagger-codegen 2.x: it is a named type, it carries its parameters as a
+// typed AcceleratorParameters rather than a raw JSON string, its
+// ProcessedDate is a *time.Time instead of string, and a failed process
+// surfaces a structured Error rather than a bare boolean.
+type ProcessResult struct {
+
+	// If needed, file to be processed by the accelerator.
+	Datafile string `json:"datafile,omitempty"`
+
+	// Parameters needed for the execution.
+	Parameters AcceleratorParameters `json:"parameters,omitempty"`
+
+	//
+	Id string `json:"id,omitempty"`
+
+	//
+	Url string `json:"url,omitempty"`
+
+	// Error describes why the process failed. It is nil on success.
+	Error *APIError `json:"error,omitempty"`
+
+	// Parameters produced by the execution.
+	ParametersResult AcceleratorParameters `json:"parametersresult,omitempty"`
+
+	// Date, in RFC3339, at which the process completed. Nil until the
+	// process finishes.
+	ProcessedDate *time.Time `json:"processed_date,omitempty"`
+
+	// If needed, file processed by the accelerator.
+	Datafileresult string `json:"datafileresult,omitempty"`
+
+	//
+	Processed bool `json:"processed,omitempty"`
+
+	// Id of the configuration to use for this process
+	Configuration string `json:"configuration,omitempty"`
+}
+
+// IsError reports whether the process failed.
+func (r *ProcessResult) IsError() bool {
+	return r.Error != nil
+}
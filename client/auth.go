@@ -0,0 +1,47 @@
+/*
+ * Accelize Accelerator WS
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package apyfal
+
+import "net/http"
+
+// Signer authenticates an outgoing request in place. Implementations must
+// be safe for concurrent use, since a Client may sign requests from
+// multiple goroutines, and must tolerate being called again on retry,
+// since a date-scoped signature goes stale between attempts.
+//
+// Marketplace deployments that authenticate accelerator access through a
+// cloud identity plug in here directly rather than through a
+// cloud-specific interface — for example AWS SigV4 via
+// github.com/aws/aws-sdk-go-v2/aws/signer/v4, an Azure AD bearer token, or
+// a GCP service account identity token. Any of those is a Signer the
+// moment it has a Sign(*http.Request) error method; Signer does not
+// prescribe which scheme, only that it can stamp a request in place.
+type Signer interface {
+	Sign(req *http.Request) error
+}
+
+// AccelizeTokenSigner authenticates with a plain Accelize API token, sent
+// as a bearer token.
+type AccelizeTokenSigner struct {
+	Token string
+}
+
+// Sign implements Signer.
+func (s AccelizeTokenSigner) Sign(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+s.Token)
+	return nil
+}
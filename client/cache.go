@@ -0,0 +1,46 @@
+/*
+ * Accelize Accelerator WS
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package apyfal
+
+import "sync"
+
+// configurationCache remembers Configurations already seen, keyed by the
+// Configuration id used on the wire (the same id carried by a
+// swagger.ProcessResult's Configuration field). A submission against an id
+// already in the cache skips the round trip that would otherwise confirm
+// the configuration exists before processing against it.
+type configurationCache struct {
+	mu      sync.RWMutex
+	entries map[string]*Configuration
+}
+
+func newConfigurationCache() *configurationCache {
+	return &configurationCache{entries: make(map[string]*Configuration)}
+}
+
+func (c *configurationCache) get(id string) (*Configuration, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	cfg, ok := c.entries[id]
+	return cfg, ok
+}
+
+func (c *configurationCache) put(cfg *Configuration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[cfg.Id] = cfg
+}
@@ -0,0 +1,103 @@
+/*
+ * Accelize Accelerator WS
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package apyfal is a hand-written, idiomatic wrapper around the generated
+// swagger package. Where the generated client is a thin transport layer,
+// this package adds retries, pagination, credential signing and
+// configuration reuse on top of it.
+package apyfal
+
+import (
+	"net/http"
+	"time"
+)
+
+const defaultBaseURL = "https://api.accelize.com"
+
+// Client is the entry point to the Accelerator WS. Create one with New.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	signer     Signer
+	retry      RetryPolicy
+	cache      *configurationCache
+}
+
+// Option configures a Client constructed with New.
+type Option func(*Client)
+
+// WithBaseURL overrides the default Accelerator WS endpoint.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) { c.baseURL = baseURL }
+}
+
+// WithHTTPClient overrides the http.Client used for requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) { c.httpClient = httpClient }
+}
+
+// WithSigner sets the credential Signer applied to every outgoing request.
+func WithSigner(signer Signer) Option {
+	return func(c *Client) { c.signer = signer }
+}
+
+// WithRetryPolicy overrides the default retry behavior.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) { c.retry = policy }
+}
+
+// New creates a Client ready to use against baseURL.
+func New(baseURL string, opts ...Option) *Client {
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+	c := &Client{
+		baseURL:    baseURL,
+		httpClient: http.DefaultClient,
+		retry:      DefaultRetryPolicy,
+		cache:      newConfigurationCache(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Configurations returns the service for creating and reusing accelerator
+// configurations.
+func (c *Client) Configurations() *ConfigurationsService {
+	return &ConfigurationsService{client: c}
+}
+
+// Results returns the service for listing past process results.
+func (c *Client) Results() *ResultsService {
+	return &ResultsService{client: c}
+}
+
+// retryAfter parses a Retry-After header, which may be either a number of
+// seconds or an HTTP-date, returning 0 if it is absent or unparseable.
+func retryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if seconds, err := time.ParseDuration(header + "s"); err == nil {
+		return seconds
+	}
+	if when, err := http.ParseTime(header); err == nil {
+		return time.Until(when)
+	}
+	return 0
+}
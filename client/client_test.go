@@ -0,0 +1,197 @@
+/*
+ * Accelize Accelerator WS
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package apyfal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	swagger "github.com/Accelize/apyfal/REST_API/go"
+)
+
+func TestClientDoRetriesOnTransientErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		statusCodes   []int
+		wantRequests  int32
+		wantFinalCode int
+	}{
+		{"succeeds after one 503", []int{http.StatusServiceUnavailable, http.StatusOK}, 2, http.StatusOK},
+		{"succeeds after one 429", []int{http.StatusTooManyRequests, http.StatusOK}, 2, http.StatusOK},
+		{"exhausts attempts on repeated 500s", []int{http.StatusInternalServerError, http.StatusInternalServerError}, 2, http.StatusInternalServerError},
+		{"does not retry on 404", []int{http.StatusNotFound}, 1, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				i := atomic.AddInt32(&requests, 1) - 1
+				code := tt.statusCodes[i]
+				if int(i) < len(tt.statusCodes)-1 {
+					w.Header().Set("Retry-After", "0")
+				}
+				w.WriteHeader(code)
+			}))
+			defer server.Close()
+
+			c := New(server.URL, WithRetryPolicy(RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}))
+			req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := c.do(context.Background(), req)
+			if err != nil {
+				t.Fatalf("do: %v", err)
+			}
+			resp.Body.Close()
+
+			if got := atomic.LoadInt32(&requests); got != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", got, tt.wantRequests)
+			}
+			if resp.StatusCode != tt.wantFinalCode {
+				t.Errorf("final status = %d, want %d", resp.StatusCode, tt.wantFinalCode)
+			}
+		})
+	}
+}
+
+func TestClientDoRespectsCancellation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := New(server.URL, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = c.do(ctx, req)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestConfigurationsGetUsesCacheOnHit(t *testing.T) {
+	var getRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			atomic.AddInt32(&getRequests, 1)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Configuration{Id: "cfg-1"})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+
+	if _, err := c.Configurations().Create(context.Background(), nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.Configurations().get(context.Background(), "cfg-1"); err != nil {
+			t.Fatalf("get: %v", err)
+		}
+	}
+
+	if got := atomic.LoadInt32(&getRequests); got != 0 {
+		t.Errorf("GET requests = %d, want 0 (cache should have served every lookup)", got)
+	}
+}
+
+func TestConfigurationsGetFetchesOnMiss(t *testing.T) {
+	var getRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&getRequests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Configuration{Id: "cfg-2"})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	if _, err := c.Configurations().get(context.Background(), "cfg-2"); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got := atomic.LoadInt32(&getRequests); got != 1 {
+		t.Errorf("GET requests = %d, want 1", got)
+	}
+
+	if _, err := c.Configurations().get(context.Background(), "cfg-2"); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got := atomic.LoadInt32(&getRequests); got != 1 {
+		t.Errorf("GET requests after cache hit = %d, want 1", got)
+	}
+}
+
+func TestRetryAfterParsesSecondsAndHTTPDate(t *testing.T) {
+	if d := retryAfter(""); d != 0 {
+		t.Errorf("empty header: got %v, want 0", d)
+	}
+	if d := retryAfter("2"); d != 2*time.Second {
+		t.Errorf("numeric header: got %v, want 2s", d)
+	}
+	future := time.Now().Add(5 * time.Second).UTC().Format(http.TimeFormat)
+	if d := retryAfter(future); d <= 0 {
+		t.Errorf("http-date header: got %v, want > 0", d)
+	}
+}
+
+func TestResultIteratorFollowsCursor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("cursor") == "" {
+			json.NewEncoder(w).Encode(resultsPage{
+				Results:    []swagger.ProcessResult{{Id: "1"}, {Id: "2"}},
+				NextCursor: "page-2",
+			})
+			return
+		}
+		json.NewEncoder(w).Encode(resultsPage{Results: []swagger.ProcessResult{{Id: "3"}}})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	it := c.Results().List(context.Background(), ListOptions{})
+
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Result().Id)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Err: %v", err)
+	}
+
+	want := fmt.Sprint([]string{"1", "2", "3"})
+	if got := fmt.Sprint(ids); got != want {
+		t.Errorf("ids = %v, want %v", got, want)
+	}
+}
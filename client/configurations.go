@@ -0,0 +1,105 @@
+/*
+ * Accelize Accelerator WS
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package apyfal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	swagger "github.com/Accelize/apyfal/REST_API/go"
+)
+
+// Configuration is a created accelerator configuration, reusable across
+// many Process calls.
+type Configuration struct {
+	Id         string                        `json:"id"`
+	Parameters swagger.AcceleratorParameters `json:"parameters,omitempty"`
+}
+
+// ConfigurationsService creates and fetches accelerator configurations.
+type ConfigurationsService struct {
+	client *Client
+}
+
+// Create registers a new configuration and caches it so a later Process
+// call against its id skips re-fetching it.
+func (s *ConfigurationsService) Create(ctx context.Context, parameters swagger.AcceleratorParameters) (*Configuration, error) {
+	payload, err := json.Marshal(struct {
+		Parameters swagger.AcceleratorParameters `json:"parameters,omitempty"`
+	}{Parameters: parameters})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.client.baseURL+"/configuration", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("apyfal: create configuration: unexpected status %d", resp.StatusCode)
+	}
+
+	var cfg Configuration
+	if err := json.NewDecoder(resp.Body).Decode(&cfg); err != nil {
+		return nil, err
+	}
+
+	s.client.cache.put(&cfg)
+	return &cfg, nil
+}
+
+// get returns the Configuration for id, using the cache when available and
+// falling back to a GET request on a cache miss.
+func (s *ConfigurationsService) get(ctx context.Context, id string) (*Configuration, error) {
+	if cfg, ok := s.client.cache.get(id); ok {
+		return cfg, nil
+	}
+
+	req, err := http.NewRequest(http.MethodGet, s.client.baseURL+"/configuration/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("apyfal: get configuration %s: unexpected status %d", id, resp.StatusCode)
+	}
+
+	var cfg Configuration
+	if err := json.NewDecoder(resp.Body).Decode(&cfg); err != nil {
+		return nil, err
+	}
+
+	s.client.cache.put(&cfg)
+	return &cfg, nil
+}
@@ -0,0 +1,68 @@
+/*
+ * Accelize Accelerator WS
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package apyfal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	swagger "github.com/Accelize/apyfal/REST_API/go"
+)
+
+// Process submits a single datafile for processing against the
+// configuration identified by cfgID. The configuration is confirmed to
+// exist before submitting; repeated calls against the same cfgID reuse the
+// Configurations() cache instead of fetching it again.
+func (c *Client) Process(ctx context.Context, cfgID string, parameters swagger.AcceleratorParameters, datafile string) (*swagger.ProcessResult, error) {
+	if _, err := c.Configurations().get(ctx, cfgID); err != nil {
+		return nil, fmt.Errorf("apyfal: process: %w", err)
+	}
+
+	payload, err := json.Marshal(struct {
+		Configuration string                        `json:"configuration"`
+		Parameters    swagger.AcceleratorParameters `json:"parameters,omitempty"`
+		Datafile      string                        `json:"datafile,omitempty"`
+	}{Configuration: cfgID, Parameters: parameters, Datafile: datafile})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/process", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("apyfal: process: unexpected status %d", resp.StatusCode)
+	}
+
+	var result swagger.ProcessResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
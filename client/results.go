@@ -0,0 +1,135 @@
+/*
+ * Accelize Accelerator WS
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package apyfal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	swagger "github.com/Accelize/apyfal/REST_API/go"
+)
+
+// ResultsService lists past process results.
+type ResultsService struct {
+	client *Client
+}
+
+// ListOptions filters and paginates a List call.
+type ListOptions struct {
+	// Configuration, if set, restricts the listing to that configuration.
+	Configuration string
+
+	// PageSize is the number of results requested per page. The server
+	// may return fewer.
+	PageSize int
+}
+
+// List returns a ResultIterator over the matching process results, in the
+// order the server returns them. The iterator follows the server's pagination cursor
+// transparently; callers never see a page boundary.
+func (s *ResultsService) List(ctx context.Context, opts ListOptions) *ResultIterator {
+	return &ResultIterator{ctx: ctx, client: s.client, opts: opts}
+}
+
+type resultsPage struct {
+	Results    []swagger.ProcessResult `json:"results"`
+	NextCursor string                  `json:"next_cursor,omitempty"`
+}
+
+// ResultIterator lazily fetches pages of results as Next is called.
+type ResultIterator struct {
+	ctx    context.Context
+	client *Client
+	opts   ListOptions
+
+	queue   []swagger.ProcessResult
+	current swagger.ProcessResult
+	cursor  string
+	done    bool
+	err     error
+}
+
+// Next advances the iterator, fetching another page from the server when
+// the current one is exhausted. It returns false once there are no more
+// results or an error occurred; check Err in that case.
+func (it *ResultIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	for len(it.queue) == 0 {
+		if it.done {
+			return false
+		}
+		if err := it.fetchPage(); err != nil {
+			it.err = err
+			return false
+		}
+	}
+	it.current, it.queue = it.queue[0], it.queue[1:]
+	return true
+}
+
+// Result returns the result the most recent call to Next advanced to.
+func (it *ResultIterator) Result() swagger.ProcessResult {
+	return it.current
+}
+
+// Err returns the first error encountered while paginating, if any.
+func (it *ResultIterator) Err() error {
+	return it.err
+}
+
+func (it *ResultIterator) fetchPage() error {
+	query := url.Values{}
+	if it.opts.Configuration != "" {
+		query.Set("configuration", it.opts.Configuration)
+	}
+	if it.opts.PageSize > 0 {
+		query.Set("page_size", fmt.Sprint(it.opts.PageSize))
+	}
+	if it.cursor != "" {
+		query.Set("cursor", it.cursor)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, it.client.baseURL+"/results?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := it.client.do(it.ctx, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("apyfal: list results: unexpected status %d", resp.StatusCode)
+	}
+
+	var page resultsPage
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return err
+	}
+
+	it.queue = page.Results
+	it.cursor = page.NextCursor
+	it.done = it.cursor == ""
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+ * Accelize Accelerator WS
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package apyfal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy controls how Client.do retries a request on transient
+// failures.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	MaxAttempts int
+
+	// BaseDelay is used when the server did not send a Retry-After header.
+	BaseDelay time.Duration
+}
+
+// DefaultRetryPolicy makes up to 5 attempts (4 retries) with a 500ms base
+// delay, doubling each retry, unless the server specifies Retry-After.
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 5, BaseDelay: 500 * time.Millisecond}
+
+func shouldRetry(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// do executes req, retrying on 429/5xx responses per c.retry, honoring a
+// Retry-After header when present and falling back to exponential backoff
+// otherwise. It closes the previous response body before retrying.
+func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+
+	attempts := c.retry.MaxAttempts
+	if attempts <= 0 {
+		attempts = 1
+	}
+
+	var resp *http.Response
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if body != nil {
+			req.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		// Re-sign on every attempt: a signature scoped to a date or
+		// timestamp (AWS SigV4 and friends) goes stale across a
+		// Retry-After delay, so replaying the first attempt's headers
+		// would get a retry rejected by the server.
+		if c.signer != nil {
+			if err := c.signer.Sign(req); err != nil {
+				return nil, fmt.Errorf("apyfal: signing request: %w", err)
+			}
+		}
+
+		resp, err = c.httpClient.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if !shouldRetry(resp.StatusCode) || attempt == attempts {
+			return resp, nil
+		}
+
+		delay := retryAfter(resp.Header.Get("Retry-After"))
+		if delay <= 0 {
+			delay = c.retry.BaseDelay * time.Duration(1<<uint(attempt-1))
+		}
+		resp.Body.Close()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+	return resp, err
+}